@@ -0,0 +1,47 @@
+package fields
+
+import (
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// BoolField is a Field rendered as a Material switch.
+type BoolField struct {
+	LabelStr string
+	Attr     string
+	Switch   widget.Bool
+}
+
+func (b *BoolField) Name() string      { return b.LabelStr }
+func (b *BoolField) Attribute() string { return b.Attr }
+
+func (b *BoolField) Value() string {
+	if b.Switch.Value {
+		return "true"
+	}
+	return "false"
+}
+
+func (b *BoolField) TypedValue() interface{} { return b.Switch.Value }
+
+func (b *BoolField) SetText(txt string) { b.Switch.Value = txt == "true" }
+
+func (b *BoolField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					l := material.Body2(th, b.LabelStr)
+					l.Color = textMain
+					l.Font.Weight = text.Bold
+					return l.Layout(gtx)
+				}),
+				layout.Rigid(material.Switch(th, &b.Switch, b.LabelStr).Layout),
+			)
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout),
+	)
+}
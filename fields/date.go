@@ -0,0 +1,140 @@
+package fields
+
+import (
+	"fmt"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// DateField is a Field that stores its value as RFC3339 and is edited via a
+// small calendar popup.
+type DateField struct {
+	LabelStr string
+	Attr     string
+
+	value time.Time
+	month time.Time
+	open  bool
+
+	clk     widget.Clickable
+	prevBtn widget.Clickable
+	nextBtn widget.Clickable
+	dayBtns []widget.Clickable
+}
+
+func (d *DateField) Name() string      { return d.LabelStr }
+func (d *DateField) Attribute() string { return d.Attr }
+
+func (d *DateField) Value() string {
+	if d.value.IsZero() {
+		return ""
+	}
+	return d.value.Format(time.RFC3339)
+}
+
+func (d *DateField) TypedValue() interface{} {
+	if d.value.IsZero() {
+		return nil
+	}
+	return d.value
+}
+
+func (d *DateField) SetText(txt string) {
+	t, err := time.Parse(time.RFC3339, txt)
+	if err != nil {
+		return
+	}
+	d.value = t
+	d.month = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+func (d *DateField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	if d.month.IsZero() {
+		now := time.Now()
+		d.month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			l := material.Body2(th, d.LabelStr)
+			l.Color = textMain
+			l.Font.Weight = text.Bold
+			return l.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if d.clk.Clicked(gtx) {
+				d.open = !d.open
+			}
+			label := "Select date"
+			if !d.value.IsZero() {
+				label = d.value.Format("2006-01-02")
+			}
+			return material.Clickable(gtx, &d.clk, func(gtx layout.Context) layout.Dimensions {
+				border := widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}
+				return border.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.UniformInset(unit.Dp(10)).Layout(gtx, material.Body1(th, label).Layout)
+				})
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !d.open {
+				return layout.Dimensions{}
+			}
+			return d.layoutCalendar(gtx, th)
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout),
+	)
+}
+
+func (d *DateField) layoutCalendar(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	daysInMonth := time.Date(d.month.Year(), d.month.Month()+1, 0, 0, 0, 0, 0, d.month.Location()).Day()
+	if len(d.dayBtns) != daysInMonth {
+		d.dayBtns = make([]widget.Clickable, daysInMonth)
+	}
+	return widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.UniformInset(unit.Dp(8)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			var rows []layout.FlexChild
+			rows = append(rows, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if d.prevBtn.Clicked(gtx) {
+					d.month = d.month.AddDate(0, -1, 0)
+				}
+				if d.nextBtn.Clicked(gtx) {
+					d.month = d.month.AddDate(0, 1, 0)
+				}
+				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return material.Clickable(gtx, &d.prevBtn, material.Body2(th, "<").Layout)
+					}),
+					layout.Rigid(material.Body2(th, d.month.Format("January 2006")).Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return material.Clickable(gtx, &d.nextBtn, material.Body2(th, ">").Layout)
+					}),
+				)
+			}))
+			for start := 0; start < daysInMonth; start += 7 {
+				start := start
+				rows = append(rows, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					var cells []layout.FlexChild
+					for day := start; day < start+7 && day < daysInMonth; day++ {
+						day := day
+						cells = append(cells, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if d.dayBtns[day].Clicked(gtx) {
+								d.value = d.month.AddDate(0, 0, day)
+								d.open = false
+							}
+							return material.Clickable(gtx, &d.dayBtns[day], func(gtx layout.Context) layout.Dimensions {
+								return layout.UniformInset(unit.Dp(6)).Layout(gtx, material.Body2(th, fmt.Sprintf("%2d", day+1)).Layout)
+							})
+						}))
+					}
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, cells...)
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+		})
+	})
+}
@@ -0,0 +1,93 @@
+package fields
+
+import "testing"
+
+func TestSelectFieldUnset(t *testing.T) {
+	s := NewSelectField("Role", "role", "admin", "member")
+
+	if got := s.Value(); got != "" {
+		t.Fatalf("Value() on a fresh SelectField = %q, want \"\"", got)
+	}
+	if got := s.TypedValue(); got != "" {
+		t.Fatalf("TypedValue() on a fresh SelectField = %v, want \"\"", got)
+	}
+	if got := s.SelectedIndex(); got != -1 {
+		t.Fatalf("SelectedIndex() on a fresh SelectField = %d, want -1", got)
+	}
+}
+
+func TestSelectFieldSetText(t *testing.T) {
+	tests := []struct {
+		name string
+		txt  string
+		want string
+	}{
+		{"known option", "member", "member"},
+		{"unknown option leaves selection unset", "nope", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSelectField("Role", "role", "admin", "member")
+			s.SetText(tt.txt)
+			if got := s.Value(); got != tt.want {
+				t.Fatalf("Value() after SetText(%q) = %q, want %q", tt.txt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFieldTypedValueUnset(t *testing.T) {
+	var d DateField
+
+	if got := d.Value(); got != "" {
+		t.Fatalf("Value() on a fresh DateField = %q, want \"\"", got)
+	}
+	if got := d.TypedValue(); got != nil {
+		t.Fatalf("TypedValue() on a fresh DateField = %v, want nil", got)
+	}
+}
+
+func TestDateFieldSetText(t *testing.T) {
+	tests := []struct {
+		name      string
+		txt       string
+		wantUnset bool
+	}{
+		{"valid RFC3339", "2024-03-05T00:00:00Z", false},
+		{"garbage leaves value unset", "not-a-date", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d DateField
+			d.SetText(tt.txt)
+			if got := d.TypedValue(); tt.wantUnset && got != nil {
+				t.Fatalf("TypedValue() after SetText(%q) = %v, want nil", tt.txt, got)
+			}
+			if !tt.wantUnset && d.Value() != tt.txt {
+				t.Fatalf("Value() after SetText(%q) = %q, want %q", tt.txt, d.Value(), tt.txt)
+			}
+		})
+	}
+}
+
+func TestNumberFieldTypedValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		editor string
+		want   interface{}
+	}{
+		{"empty is unset", "", nil},
+		{"valid float", "42.5", 42.5},
+		{"multiple dots is invalid", "1.2.3", nil},
+		{"stray dash is invalid", "1-2", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumberField{}
+			n.Editor.SetText(tt.editor)
+			if got := n.TypedValue(); got != tt.want {
+				t.Fatalf("TypedValue() for editor text %q = %v, want %v", tt.editor, got, tt.want)
+			}
+		})
+	}
+}
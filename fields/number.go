@@ -0,0 +1,65 @@
+package fields
+
+import (
+	"strconv"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// NumberField is a TextField for numeric input. Typing is limited to digits,
+// '.' and '-', but malformed text such as "1.2.3" can still be entered;
+// TypedValue reports nil for any text that does not parse as a number.
+type NumberField struct {
+	LabelStr string
+	Attr     string
+	Editor   widget.Editor
+}
+
+func (n *NumberField) Name() string      { return n.LabelStr }
+func (n *NumberField) Attribute() string { return n.Attr }
+func (n *NumberField) Value() string     { return n.Editor.Text() }
+
+func (n *NumberField) TypedValue() interface{} {
+	txt := n.Editor.Text()
+	if txt == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(txt, 64)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// SetText only applies txt if it parses as a number, so a NumberField can
+// never be driven into an invalid state by its caller.
+func (n *NumberField) SetText(txt string) {
+	if _, err := strconv.ParseFloat(txt, 64); err != nil {
+		return
+	}
+	n.Editor.SetText(txt)
+}
+
+func (n *NumberField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	n.Editor.SingleLine = true
+	n.Editor.Filter = "0123456789.-"
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			l := material.Body2(th, n.LabelStr)
+			l.Color = textMain
+			l.Font.Weight = text.Bold
+			return l.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			border := widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}
+			return border.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(10)).Layout(gtx, material.Editor(th, &n.Editor, "").Layout)
+			})
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout),
+	)
+}
@@ -0,0 +1,75 @@
+package fields
+
+import (
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+var (
+	visibilityIcon, _    = widget.NewIcon(icons.ActionVisibility)
+	visibilityOffIcon, _ = widget.NewIcon(icons.ActionVisibilityOff)
+)
+
+// PasswordField is a TextField that masks its contents and offers a
+// toggle-visibility eye icon.
+type PasswordField struct {
+	LabelStr string
+	Attr     string
+	Editor   widget.Editor
+
+	toggle  widget.Clickable
+	visible bool
+}
+
+func (p *PasswordField) Name() string            { return p.LabelStr }
+func (p *PasswordField) Attribute() string       { return p.Attr }
+func (p *PasswordField) Value() string           { return p.Editor.Text() }
+func (p *PasswordField) TypedValue() interface{} { return p.Value() }
+func (p *PasswordField) SetText(txt string)      { p.Editor.SetText(txt) }
+
+func (p *PasswordField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	p.Editor.SingleLine = true
+	if p.visible {
+		p.Editor.Mask = 0
+	} else {
+		p.Editor.Mask = '•'
+	}
+	if p.toggle.Clicked(gtx) {
+		p.visible = !p.visible
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			l := material.Body2(th, p.LabelStr)
+			l.Color = textMain
+			l.Font.Weight = text.Bold
+			return l.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			border := widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}
+			return border.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(unit.Dp(10)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+						layout.Flexed(1, material.Editor(th, &p.Editor, "").Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							icon := visibilityIcon
+							if p.visible {
+								icon = visibilityOffIcon
+							}
+							btn := material.IconButton(th, &p.toggle, icon, "Toggle password visibility")
+							btn.Size = unit.Dp(18)
+							btn.Inset = layout.UniformInset(unit.Dp(4))
+							return btn.Layout(gtx)
+						}),
+					)
+				})
+			})
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout),
+	)
+}
@@ -0,0 +1,118 @@
+package fields
+
+import (
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+)
+
+// SelectField is a Field backed by a popup list of enum choices. Clicking
+// the field opens a list of Options; clicking an option selects it and
+// closes the list.
+type SelectField struct {
+	LabelStr string
+	Attr     string
+	Options  []string
+
+	selected int
+	open     bool
+
+	clk     widget.Clickable
+	optBtns []widget.Clickable
+}
+
+// NewSelectField returns a SelectField with no option selected. The zero
+// value of SelectField reports index 0 as selected (a valid choice), so
+// callers must go through this constructor rather than a bare struct
+// literal to get an honest "nothing chosen yet" state.
+func NewSelectField(label, attr string, options ...string) *SelectField {
+	return &SelectField{LabelStr: label, Attr: attr, Options: options, selected: -1}
+}
+
+// Reset clears any selection, leaving Options untouched.
+func (s *SelectField) Reset() {
+	s.selected = -1
+}
+
+func (s *SelectField) Name() string      { return s.LabelStr }
+func (s *SelectField) Attribute() string { return s.Attr }
+
+func (s *SelectField) Value() string {
+	if s.selected < 0 || s.selected >= len(s.Options) {
+		return ""
+	}
+	return s.Options[s.selected]
+}
+
+func (s *SelectField) TypedValue() interface{} { return s.Value() }
+
+// SelectedIndex returns the index into Options currently selected, or -1 if
+// nothing is selected or Options is empty.
+func (s *SelectField) SelectedIndex() int {
+	if len(s.Options) == 0 {
+		return -1
+	}
+	return s.selected
+}
+
+func (s *SelectField) SetText(txt string) {
+	for i, opt := range s.Options {
+		if opt == txt {
+			s.selected = i
+			return
+		}
+	}
+}
+
+func (s *SelectField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
+	if len(s.optBtns) != len(s.Options) {
+		s.optBtns = make([]widget.Clickable, len(s.Options))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			l := material.Body2(th, s.LabelStr)
+			l.Color = textMain
+			l.Font.Weight = text.Bold
+			return l.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if s.clk.Clicked(gtx) {
+				s.open = !s.open
+			}
+			label := s.Value()
+			if label == "" {
+				label = "Select..."
+			}
+			return material.Clickable(gtx, &s.clk, func(gtx layout.Context) layout.Dimensions {
+				border := widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}
+				return border.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.UniformInset(unit.Dp(10)).Layout(gtx, material.Body1(th, label).Layout)
+				})
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !s.open {
+				return layout.Dimensions{}
+			}
+			return widget.Border{Color: borderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				var kids []layout.FlexChild
+				for i := range s.Options {
+					i := i
+					kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if s.optBtns[i].Clicked(gtx) {
+							s.selected = i
+							s.open = false
+						}
+						return material.Clickable(gtx, &s.optBtns[i], func(gtx layout.Context) layout.Dimensions {
+							return layout.UniformInset(unit.Dp(10)).Layout(gtx, material.Body1(th, s.Options[i]).Layout)
+						})
+					}))
+				}
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, kids...)
+			})
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout),
+	)
+}
@@ -0,0 +1,12 @@
+package fields
+
+import "image/color"
+
+// Mirrors the Nova palette in the main package. Field implementations live
+// in their own package (to avoid an import cycle with the Resource/Field
+// interfaces they satisfy structurally), so the handful of shared colors
+// are kept here rather than threaded through every constructor.
+var (
+	textMain  = color.NRGBA{R: 55, G: 65, B: 81, A: 255}
+	borderCol = color.NRGBA{R: 229, G: 231, B: 235, A: 255}
+)
@@ -2,15 +2,25 @@ package main
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"image"
 	"image/color"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"gioui.org/app"
 	"gioui.org/font/gofont"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
 	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op"
@@ -22,8 +32,31 @@ import (
 	"gioui.org/widget/material"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/saimirballeshi/gova/fields"
+	"golang.org/x/crypto/scrypt"
 )
 
+// Toast severity levels for App.Notify.
+const (
+	LevelInfo = iota
+	LevelError
+	LevelSuccess
+)
+
+// toastDuration is how long a message stays on screen before it fades away.
+const toastDuration = 4 * time.Second
+
+// historyLimit is the number of past Cypher queries kept in App.History.
+const historyLimit = 50
+
+// defaultPageSize is the number of rows fetched per table page.
+const defaultPageSize = 25
+
+// relOptionLimit bounds the endpoint lists loaded for a relationship's
+// SelectFields; it is generous rather than paginated since those dropdowns
+// need the full option set.
+const relOptionLimit = 1000
+
 // ==========================================
 // 1. THEME & STYLING (The "Nova" Look)
 // ==========================================
@@ -64,12 +97,26 @@ type Resource interface {
 	Fields() []Field
 }
 
+// RelationshipResource models a Neo4j relationship type as a first-class
+// citizen alongside node Resources, so the admin UI can browse and create
+// edges, not just nodes.
+type RelationshipResource interface {
+	Type() string
+	From() Resource
+	To() Resource
+	Fields() []Field
+}
+
 type Field interface {
 	Name() string
 	Attribute() string
 	Layout(gtx layout.Context, th *material.Theme) layout.Dimensions
 	SetText(txt string)
 	Value() string
+	// TypedValue returns the field's value as the Go type Neo4j should
+	// store it as (string, bool, int64, time.Time, ...), so Repository.Store
+	// doesn't have to stringify everything into $props.
+	TypedValue() interface{}
 }
 
 // ==========================================
@@ -88,13 +135,48 @@ func NewRepository(uri, username, password string) *Repository {
 	return &Repository{Driver: driver}
 }
 
-func (r *Repository) Index(ctx context.Context, res Resource) ([]*neo4j.Record, error) {
-	cypher := fmt.Sprintf("MATCH (n:%s) RETURN n LIMIT 25", res.Label())
-	result, err := neo4j.ExecuteQuery(ctx, r.Driver, cypher, nil, neo4j.EagerResultTransformer)
+// Index returns one page of res's nodes (offset/limit, server-side SKIP/LIMIT)
+// along with the total matching count, both read in a single transaction so
+// the count can't drift relative to the page it describes.
+func (r *Repository) Index(ctx context.Context, res Resource, offset, limit int) ([]*neo4j.Record, int64, error) {
+	session := r.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close(ctx)
+
+	type page struct {
+		records []*neo4j.Record
+		total   int64
+	}
+
+	result, err := session.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (interface{}, error) {
+		pageCypher := fmt.Sprintf("MATCH (n:%s) RETURN n SKIP $offset LIMIT $limit", res.Label())
+		pageResult, err := tx.Run(ctx, pageCypher, map[string]interface{}{"offset": offset, "limit": limit})
+		if err != nil {
+			return nil, err
+		}
+		records, err := pageResult.Collect(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		countCypher := fmt.Sprintf("MATCH (n:%s) RETURN count(n) AS c", res.Label())
+		countResult, err := tx.Run(ctx, countCypher, nil)
+		if err != nil {
+			return nil, err
+		}
+		countRecord, err := countResult.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		total, _ := countRecord.Values[0].(int64)
+
+		return page{records: records, total: total}, nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return result.Records, nil
+
+	p := result.(page)
+	return p.records, p.total, nil
 }
 
 func (r *Repository) Store(ctx context.Context, res Resource, data map[string]interface{}) error {
@@ -103,6 +185,194 @@ func (r *Repository) Store(ctx context.Context, res Resource, data map[string]in
 	return err
 }
 
+func (r *Repository) IndexRelationships(ctx context.Context, rr RelationshipResource) ([]*neo4j.Record, error) {
+	cypher := fmt.Sprintf("MATCH (a:%s)-[r:%s]->(b:%s) RETURN a, r, b LIMIT 25", rr.From().Label(), rr.Type(), rr.To().Label())
+	result, err := neo4j.ExecuteQuery(ctx, r.Driver, cypher, nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		return nil, err
+	}
+	return result.Records, nil
+}
+
+func (r *Repository) StoreRelationship(ctx context.Context, rr RelationshipResource, fromID, toID int64, props map[string]interface{}) error {
+	cypher := fmt.Sprintf("MATCH (a),(b) WHERE id(a)=$from AND id(b)=$to CREATE (a)-[r:%s $props]->(b)", rr.Type())
+	_, err := neo4j.ExecuteQuery(ctx, r.Driver, cypher, map[string]interface{}{"from": fromID, "to": toID, "props": props}, neo4j.EagerResultTransformer)
+	return err
+}
+
+// RunRaw executes an arbitrary Cypher statement, for the Query Console.
+func (r *Repository) RunRaw(ctx context.Context, cypher string, params map[string]any) (keys []string, records []*neo4j.Record, err error) {
+	result, err := neo4j.ExecuteQuery(ctx, r.Driver, cypher, params, neo4j.EagerResultTransformer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Keys, result.Records, nil
+}
+
+// DeleteNode detach-deletes a single node by its internal id, used by the
+// table's per-row delete action once the user confirms via ModalConfirm.
+func (r *Repository) DeleteNode(ctx context.Context, label string, id int64) error {
+	cypher := fmt.Sprintf("MATCH (n:%s) WHERE id(n)=$id DETACH DELETE n", label)
+	_, err := neo4j.ExecuteQuery(ctx, r.Driver, cypher, map[string]interface{}{"id": id}, neo4j.EagerResultTransformer)
+	return err
+}
+
+// configDir returns $XDG_CONFIG_HOME/gova (or ~/.config/gova), creating it
+// if necessary. Shared by stateStore and credStore.
+func configDir() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	dir = filepath.Join(dir, "gova")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// stateStore persists small pieces of local state, such as query history,
+// to a JSON file under $XDG_CONFIG_HOME/gova, modeled on Tailscale's Gio UI
+// state store.
+type stateStore struct {
+	path string
+}
+
+func newStateStore(name string) (*stateStore, error) {
+	dir, err := configDir()
+	if err != nil {
+		return nil, err
+	}
+	return &stateStore{path: filepath.Join(dir, name)}, nil
+}
+
+func (s *stateStore) Load(v interface{}) error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (s *stateStore) Save(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+// credentials holds the Neo4j connection info that used to be hardcoded in
+// main; it's persisted encrypted via credStore instead.
+type credentials struct {
+	URI      string `json:"uri"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// scrypt parameters for deriving the AES-GCM key from the user's PIN.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// credStore persists credentials to $XDG_CONFIG_HOME/gova/creds.enc as
+// salt(16) || nonce(12) || ciphertext, encrypted with a key scrypt-derived
+// from the user's PIN so the file is useless without it.
+type credStore struct {
+	path string
+}
+
+func newCredStore() (*credStore, error) {
+	dir, err := configDir()
+	if err != nil {
+		return nil, err
+	}
+	return &credStore{path: filepath.Join(dir, "creds.enc")}, nil
+}
+
+// Exists reports whether a credentials file has already been set up.
+func (c *credStore) Exists() bool {
+	_, err := os.Stat(c.path)
+	return err == nil
+}
+
+// Save encrypts creds under a key derived from pin and writes it to disk.
+func (c *credStore) Save(pin string, creds credentials) error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	gcm, err := c.cipher(pin, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
+
+	data := make([]byte, 0, len(salt)+len(nonce)+len(ciphertext))
+	data = append(data, salt...)
+	data = append(data, nonce...)
+	data = append(data, ciphertext...)
+	return os.WriteFile(c.path, data, 0o600)
+}
+
+// Load decrypts the stored credentials with pin, returning an error (e.g.
+// an AES-GCM auth failure) if the PIN is wrong.
+func (c *credStore) Load(pin string) (credentials, error) {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return credentials{}, err
+	}
+	if len(data) < 16+12 {
+		return credentials{}, errors.New("credentials file is corrupt")
+	}
+	salt, nonce, ciphertext := data[:16], data[16:28], data[28:]
+
+	gcm, err := c.cipher(pin, salt)
+	if err != nil {
+		return credentials{}, err
+	}
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return credentials{}, err
+	}
+	var creds credentials
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return credentials{}, err
+	}
+	return creds, nil
+}
+
+// cipher derives the AES-GCM key for pin+salt via scrypt.
+func (c *credStore) cipher(pin string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(pin), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
 // ==========================================
 // 4. FIELDS & RESOURCES
 // ==========================================
@@ -116,6 +386,7 @@ type TextField struct {
 func (t *TextField) Name() string { return t.LabelStr }
 func (t *TextField) Attribute() string { return t.Attr }
 func (t *TextField) Value() string { return t.Editor.Text() }
+func (t *TextField) TypedValue() interface{} { return t.Editor.Text() }
 func (t *TextField) SetText(txt string) { t.Editor.SetText(txt) }
 
 func (t *TextField) Layout(gtx layout.Context, th *material.Theme) layout.Dimensions {
@@ -146,6 +417,63 @@ func (u UserResource) Fields() []Field {
 	return []Field{
 		&TextField{LabelStr: "Full Name", Attr: "name", Editor: widget.Editor{SingleLine: true}},
 		&TextField{LabelStr: "Email Address", Attr: "email", Editor: widget.Editor{SingleLine: true}},
+		&fields.NumberField{LabelStr: "Age", Attr: "age"},
+		fields.NewSelectField("Role", "role", "Admin", "Editor", "Viewer"),
+		&fields.BoolField{LabelStr: "Active", Attr: "active"},
+		&fields.DateField{LabelStr: "Created At", Attr: "createdAt"},
+		&fields.PasswordField{LabelStr: "Password", Attr: "password", Editor: widget.Editor{SingleLine: true}},
+	}
+}
+
+// FriendOfRelationship demonstrates RelationshipResource: a User-to-User
+// edge with its own properties.
+type FriendOfRelationship struct{}
+func (FriendOfRelationship) Type() string   { return "FRIEND_OF" }
+func (FriendOfRelationship) From() Resource { return UserResource{} }
+func (FriendOfRelationship) To() Resource   { return UserResource{} }
+func (FriendOfRelationship) Fields() []Field {
+	return []Field{
+		&TextField{LabelStr: "Since", Attr: "since", Editor: widget.Editor{SingleLine: true}},
+	}
+}
+
+// nodeLabel picks the first prop of res's fields present on node, for
+// display in a table row — mirrors renderTable's own "first prop found" demo
+// logic so relationship rows read the same way as node rows.
+func nodeLabel(res Resource, node neo4j.Node) string {
+	for _, f := range res.Fields() {
+		if val, ok := node.Props[f.Attribute()]; ok {
+			return formatProp(val)
+		}
+	}
+	return "Node"
+}
+
+// relFormState holds the two endpoint SelectFields of the relationship
+// create form, populated asynchronously from Repository.Index once the
+// endpoints' node lists have loaded.
+type relFormState struct {
+	From fields.SelectField
+	To   fields.SelectField
+
+	fromIDs []int64
+	toIDs   []int64
+}
+
+func (s *relFormState) setOptions(rr RelationshipResource, fromRecs, toRecs []*neo4j.Record) {
+	*s = relFormState{
+		From: *fields.NewSelectField("From "+rr.From().Label(), ""),
+		To:   *fields.NewSelectField("To "+rr.To().Label(), ""),
+	}
+	for _, rec := range fromRecs {
+		node := rec.Values[0].(neo4j.Node)
+		s.From.Options = append(s.From.Options, nodeLabel(rr.From(), node))
+		s.fromIDs = append(s.fromIDs, node.Id)
+	}
+	for _, rec := range toRecs {
+		node := rec.Values[0].(neo4j.Node)
+		s.To.Options = append(s.To.Options, nodeLabel(rr.To(), node))
+		s.toIDs = append(s.toIDs, node.Id)
 	}
 }
 
@@ -158,71 +486,452 @@ type App struct {
 	Theme      *material.Theme
 	Resources  []Resource
 	CurrentRes Resource
-	
+
+	Relationships []RelationshipResource
+	CurrentRel    RelationshipResource
+
 	// State
-	View       string // "index", "create"
+	View       string // "index", "create", "relationships", "relationship-create", "cypher"
 	CachedData []*neo4j.Record
-	
+
+	// Pagination over CachedData: Page/PageSize describe the last page
+	// explicitly requested via Prev/Next; Total is the server-side count.
+	// Infinite scroll appends further pages onto CachedData without
+	// advancing Page, so pageSummary's range starts at the requested page
+	// and extends over every appended row.
+	Page        int
+	PageSize    int
+	Total       int64
+	loadingMore bool
+	cancelFetch context.CancelFunc
+
+	CachedRelData []*neo4j.Record
+	RelForm       relFormState
+
 	// Widgets
 	NavList    widget.List
 	NavButtons []*widget.Clickable
 	TableList  widget.List
 	CreateBtn  widget.Clickable
 	SaveBtn    widget.Clickable
-	
-	Window     *app.Window
+	DeleteBtns []widget.Clickable
+	PrevBtn    widget.Clickable
+	NextBtn    widget.Clickable
+
+	RelList      widget.List
+	RelButtons   []*widget.Clickable
+	RelTableList widget.List
+	CreateRelBtn widget.Clickable
+	SaveRelBtn   widget.Clickable
+
+	// Query Console
+	QueryConsoleBtn widget.Clickable
+	CypherEditor    widget.Editor
+	RunBtn          widget.Clickable
+	HistoryUpBtn    widget.Clickable
+	ResultsList     widget.List
+	CypherKeys      []string
+	CypherResults   []*neo4j.Record
+	History         []string
+	HistoryIdx      int
+	history         *stateStore
+
+	Window *app.Window
+
+	// Toast notification (see Notify)
+	Msg message
+
+	// Modal dialog (confirm/PIN/input), see Modal.Show
+	Modal Modal
+}
+
+// ModalKind selects what Modal renders below its description: nothing
+// (ModalConfirm), a masked PIN editor (ModalPin), or a plain editor
+// (ModalInput).
+type ModalKind int
+
+const (
+	ModalConfirm ModalKind = iota
+	ModalPin
+	ModalInput
+)
+
+// Modal is a centered confirmation/input dialog drawn as an overlay over
+// the whole frame. Only one Modal can be active on an App at a time.
+type Modal struct {
+	visible     bool
+	title       string
+	description string
+	kind        ModalKind
+	cb          func(result string, ok bool)
+
+	Editor widget.Editor
+	OKBtn  widget.Clickable
+	Cancel widget.Clickable
+}
+
+// Show displays the dialog with the given title, description and kind. cb
+// is invoked once, with the editor's text (ignored for ModalConfirm) and
+// whether the user chose OK (true) or Cancel/Escape (false).
+func (m *Modal) Show(title, description string, kind ModalKind, cb func(result string, ok bool)) {
+	m.visible = true
+	m.title = title
+	m.description = description
+	m.kind = kind
+	m.cb = cb
+	m.Editor = widget.Editor{SingleLine: true}
+	if kind == ModalPin {
+		m.Editor.Mask = '•'
+	}
+}
+
+// resolve hides the dialog and invokes its callback, if any, exactly once.
+func (m *Modal) resolve(result string, ok bool) {
+	cb := m.cb
+	m.visible = false
+	m.cb = nil
+	if cb != nil {
+		cb(result, ok)
+	}
+}
+
+// message is a transient toast shown in the bottom-right corner of the
+// window, modeled on Tailscale's Gio UI notification banner.
+type message struct {
+	text  string
+	t0    time.Time
+	level int
+}
+
+// Notify records a toast to display for toastDuration and wakes the window
+// so it gets painted (and, via renderToast's InvalidateOp, painted again
+// when it's time for it to disappear).
+func (a *App) Notify(level int, format string, args ...interface{}) {
+	a.Msg = message{text: fmt.Sprintf(format, args...), t0: time.Now(), level: level}
+	a.Window.Invalidate()
 }
 
 func (a *App) Layout(gtx layout.Context) layout.Dimensions {
 	// 1. Paint Background
 	paint.FillShape(gtx.Ops, BgMain, clip.Rect{Max: gtx.Constraints.Max}.Op())
 
-	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
-		// Sidebar
-		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			return a.renderSidebar(gtx)
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				// Sidebar
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return a.renderSidebar(gtx)
+				}),
+				// Content
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return layout.UniformInset(unit.Dp(30)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						switch a.View {
+						case "create":
+							return a.renderForm(gtx)
+						case "relationships":
+							return a.renderRelTable(gtx)
+						case "relationship-create":
+							return a.renderRelForm(gtx)
+						case "cypher":
+							return a.renderCypherConsole(gtx)
+						default:
+							return a.renderTable(gtx)
+						}
+					})
+				}),
+			)
 		}),
-		// Content
-		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
-			return layout.UniformInset(unit.Dp(30)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				if a.View == "create" {
-					return a.renderForm(gtx)
-				}
-				return a.renderTable(gtx)
-			})
+		// Toast overlay, always on top
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return a.renderToast(gtx)
+		}),
+		// Modal overlay: recorded via op.Defer so it always paints over the
+		// rest of the frame, even when Show was called from deep inside a
+		// row's delete button.
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			macro := op.Record(gtx.Ops)
+			dims := a.renderModal(gtx)
+			call := macro.Stop()
+			op.Defer(gtx.Ops, call)
+			return dims
 		}),
 	)
 }
 
+// renderToast draws the current message, if any, as a floating card in the
+// bottom-right corner, colored by level. It schedules its own invalidation
+// for the moment the toast needs to disappear, so the window doesn't have to
+// poll.
+func (a *App) renderToast(gtx layout.Context) layout.Dimensions {
+	if a.Msg.text == "" {
+		return layout.Dimensions{}
+	}
+	deadline := a.Msg.t0.Add(toastDuration)
+	if !time.Now().Before(deadline) {
+		return layout.Dimensions{}
+	}
+	op.InvalidateOp{At: deadline}.Add(gtx.Ops)
+
+	bg := NovaBlue
+	switch a.Msg.level {
+	case LevelError:
+		bg = color.NRGBA{R: 220, G: 38, B: 38, A: 255}
+	case LevelSuccess:
+		bg = color.NRGBA{R: 22, G: 163, B: 74, A: 255}
+	}
+
+	return layout.SE.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Inset{Right: unit.Dp(20), Bottom: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			defer clip.RRect{
+				Rect: image.Rectangle{Max: gtx.Constraints.Min},
+				SE:   gtx.Dp(8), SW: gtx.Dp(8), NW: gtx.Dp(8), NE: gtx.Dp(8),
+			}.Push(gtx.Ops).Pop()
+			paint.Fill(gtx.Ops, bg)
+			return layout.UniformInset(unit.Dp(14)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				l := material.Body2(a.Theme, a.Msg.text)
+				l.Color = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+				return l.Layout(gtx)
+			})
+		})
+	})
+}
+
+// renderModal draws App.Modal as a scrim over the whole frame with a
+// centered DrawCard: title, description, an editor for non-confirm kinds,
+// and Cancel/OK buttons. key.NameReturn resolves OK and key.NameEscape
+// resolves Cancel while the modal is visible.
+func (a *App) renderModal(gtx layout.Context) layout.Dimensions {
+	m := &a.Modal
+	if !m.visible {
+		return layout.Dimensions{}
+	}
+
+	area := clip.Rect{Max: gtx.Constraints.Max}.Push(gtx.Ops)
+	key.InputOp{Tag: m, Keys: key.NameReturn + "|" + key.NameEscape}.Add(gtx.Ops)
+	// Grab all pointer events over the scrim so clicks (and scroll wheel
+	// events, which would otherwise fall through to the list) can't reach
+	// whatever is rendered underneath while the modal is visible.
+	pointer.InputOp{Tag: m, Grab: true, Kinds: pointer.Press | pointer.Release | pointer.Move | pointer.Scroll | pointer.Drag | pointer.Cancel}.Add(gtx.Ops)
+	area.Pop()
+	for _, e := range gtx.Events(m) {
+		if ke, ok := e.(key.Event); ok && ke.State == key.Press {
+			switch ke.Name {
+			case key.NameReturn:
+				m.resolve(m.Editor.Text(), true)
+			case key.NameEscape:
+				m.resolve("", false)
+			}
+		}
+	}
+
+	paint.FillShape(gtx.Ops, color.NRGBA{A: 160}, clip.Rect{Max: gtx.Constraints.Max}.Op())
+
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Dp(360)
+			return layout.UniformInset(unit.Dp(24)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				var kids []layout.FlexChild
+				kids = append(kids, layout.Rigid(material.H6(a.Theme, m.title).Layout))
+				kids = append(kids, layout.Rigid(layout.Spacer{Height: unit.Dp(10)}.Layout))
+				kids = append(kids, layout.Rigid(material.Body2(a.Theme, m.description).Layout))
+				kids = append(kids, layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout))
+
+				if m.kind != ModalConfirm {
+					kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						border := widget.Border{Color: BorderCol, CornerRadius: unit.Dp(4), Width: unit.Dp(1)}
+						return border.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							return layout.UniformInset(unit.Dp(10)).Layout(gtx, material.Editor(a.Theme, &m.Editor, "").Layout)
+						})
+					}))
+					kids = append(kids, layout.Rigid(layout.Spacer{Height: unit.Dp(15)}.Layout))
+				}
+
+				kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						layout.Flexed(1, layout.Spacer{}.Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if m.Cancel.Clicked(gtx) {
+								m.resolve("", false)
+							}
+							return material.Button(a.Theme, &m.Cancel, "Cancel").Layout(gtx)
+						}),
+						layout.Rigid(layout.Spacer{Width: unit.Dp(10)}.Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if m.OKBtn.Clicked(gtx) {
+								m.resolve(m.Editor.Text(), true)
+							}
+							btn := material.Button(a.Theme, &m.OKBtn, "OK")
+							btn.Background = NovaBlue
+							return btn.Layout(gtx)
+						}),
+					)
+				}))
+
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, kids...)
+			})
+		})
+	})
+}
+
 func (a *App) renderSidebar(gtx layout.Context) layout.Dimensions {
 	gtx.Constraints.Min.X = gtx.Dp(250)
 	gtx.Constraints.Max.X = gtx.Dp(250)
 	paint.FillShape(gtx.Ops, BgSidebar, clip.Rect{Max: gtx.Constraints.Max}.Op())
 	
 	return layout.Inset{Top: unit.Dp(30)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-		return material.List(a.Theme, &a.NavList).Layout(gtx, len(a.Resources), func(gtx layout.Context, i int) layout.Dimensions {
-			if a.NavButtons[i].Clicked(gtx) {
-				a.CurrentRes = a.Resources[i]
-				a.View = "index"
-				a.fetchData()
-			}
-			
-			// Custom Sidebar Button
-			return material.Clickable(gtx, a.NavButtons[i], func(gtx layout.Context) layout.Dimensions {
-				return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-					l := material.Body1(a.Theme, a.Resources[i].Label())
-					l.Color = color.NRGBA{200, 200, 200, 255}
-					if a.CurrentRes == a.Resources[i] {
-						l.Color = NovaBlue
-						l.Font.Weight = text.Bold
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return material.List(a.Theme, &a.NavList).Layout(gtx, len(a.Resources), func(gtx layout.Context, i int) layout.Dimensions {
+					if a.NavButtons[i].Clicked(gtx) {
+						a.CurrentRes = a.Resources[i]
+						a.View = "index"
+						a.fetchData()
 					}
-					return l.Layout(gtx)
+
+					// Custom Sidebar Button
+					return material.Clickable(gtx, a.NavButtons[i], func(gtx layout.Context) layout.Dimensions {
+						return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							l := material.Body1(a.Theme, a.Resources[i].Label())
+							l.Color = color.NRGBA{200, 200, 200, 255}
+							if a.CurrentRes == a.Resources[i] {
+								l.Color = NovaBlue
+								l.Font.Weight = text.Bold
+							}
+							return l.Layout(gtx)
+						})
+					})
 				})
-			})
-		})
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if len(a.Relationships) == 0 {
+					return layout.Dimensions{}
+				}
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return layout.Inset{Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							l := material.Body2(a.Theme, "RELATIONSHIPS")
+							l.Color = color.NRGBA{R: 148, G: 163, B: 184, A: 255}
+							l.Font.Weight = text.Bold
+							return l.Layout(gtx)
+						})
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return material.List(a.Theme, &a.RelList).Layout(gtx, len(a.Relationships), func(gtx layout.Context, i int) layout.Dimensions {
+							if a.RelButtons[i].Clicked(gtx) {
+								a.CurrentRel = a.Relationships[i]
+								a.View = "relationships"
+								a.fetchRelData()
+							}
+
+							return material.Clickable(gtx, a.RelButtons[i], func(gtx layout.Context) layout.Dimensions {
+								return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+									l := material.Body1(a.Theme, a.Relationships[i].Type())
+									l.Color = color.NRGBA{200, 200, 200, 255}
+									if a.CurrentRel == a.Relationships[i] {
+										l.Color = NovaBlue
+										l.Font.Weight = text.Bold
+									}
+									return l.Layout(gtx)
+								})
+							})
+						})
+					}),
+				)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return layout.Inset{Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							l := material.Body2(a.Theme, "TOOLS")
+							l.Color = color.NRGBA{R: 148, G: 163, B: 184, A: 255}
+							l.Font.Weight = text.Bold
+							return l.Layout(gtx)
+						})
+					}),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if a.QueryConsoleBtn.Clicked(gtx) {
+							a.View = "cypher"
+						}
+						return material.Clickable(gtx, &a.QueryConsoleBtn, func(gtx layout.Context) layout.Dimensions {
+							return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								l := material.Body1(a.Theme, "Query Console")
+								l.Color = color.NRGBA{200, 200, 200, 255}
+								if a.View == "cypher" {
+									l.Color = NovaBlue
+									l.Font.Weight = text.Bold
+								}
+								return l.Layout(gtx)
+							})
+						})
+					}),
+				)
+			}),
+		)
 	})
 }
 
+// formatProp renders a Neo4j node property per its Go type, so a bool shows
+// as a check and a time.Time shows as a relative "time ago" string instead
+// of every field being stringified the same way.
+func formatProp(v interface{}) string {
+	switch val := v.(type) {
+	case bool:
+		if val {
+			return "✓"
+		}
+		return ""
+	case time.Time:
+		return timeago(val)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// formatRecordValue renders one column of a Query Console result row,
+// pretty-printing nodes and relationships the way Cypher itself would
+// rather than dumping their Go struct representation.
+func formatRecordValue(v interface{}) string {
+	switch val := v.(type) {
+	case neo4j.Node:
+		return fmt.Sprintf("(:%s %s)", strings.Join(val.Labels, ":"), formatProps(val.Props))
+	case neo4j.Relationship:
+		return fmt.Sprintf("[:%s %s]", val.Type, formatProps(val.Props))
+	default:
+		return formatProp(val)
+	}
+}
+
+// formatProps renders a property map as "{k:v,k2:v2}" with keys sorted, so
+// the same node prints identically across runs.
+func formatProps(props map[string]interface{}) string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s:%s", k, formatProp(props[k])))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func timeago(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
+
 func (a *App) renderTable(gtx layout.Context) layout.Dimensions {
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 		// Header Area
@@ -239,14 +948,55 @@ func (a *App) renderTable(gtx layout.Context) layout.Dimensions {
 				}),
 			)
 		}),
-		layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(10)}.Layout),
+		// Pagination Bar: "Showing X–Y of Z" plus prev/next, disabled at bounds.
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			hasPrev := a.Page > 0
+			// Cumulative rows seen so far (page start + what's loaded), not
+			// just len(CachedData): loadPage replaces CachedData with only
+			// the current page, so len() alone undercounts position once
+			// past page 0 and Next never disables on the last short page.
+			hasNext := int64(a.Page)*int64(a.PageSize)+int64(len(a.CachedData)) < a.Total
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(material.Body2(a.Theme, a.pageSummary()).Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if hasPrev && a.PrevBtn.Clicked(gtx) {
+								a.loadPage(a.Page - 1)
+							}
+							btn := material.Button(a.Theme, &a.PrevBtn, "Prev")
+							if !hasPrev {
+								btn.Background = BorderCol
+							}
+							return btn.Layout(gtx)
+						}),
+						layout.Rigid(layout.Spacer{Width: unit.Dp(10)}.Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if hasNext && a.NextBtn.Clicked(gtx) {
+								a.loadPage(a.Page + 1)
+							}
+							btn := material.Button(a.Theme, &a.NextBtn, "Next")
+							if !hasNext {
+								btn.Background = BorderCol
+							}
+							return btn.Layout(gtx)
+						}),
+					)
+				}),
+			)
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(10)}.Layout),
 		// Table Card
 		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
 			return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
-				return material.List(a.Theme, &a.TableList).Layout(gtx, len(a.CachedData), func(gtx layout.Context, i int) layout.Dimensions {
+				if len(a.DeleteBtns) != len(a.CachedData) {
+					a.DeleteBtns = make([]widget.Clickable, len(a.CachedData))
+				}
+				dims := material.List(a.Theme, &a.TableList).Layout(gtx, len(a.CachedData), func(gtx layout.Context, i int) layout.Dimensions {
 					// Extract Node Props
 					node := a.CachedData[i].Values[0].(neo4j.Node)
-					
+
 					// Row Layout
 					return layout.Stack{}.Layout(gtx,
 						layout.Expanded(func(gtx layout.Context) layout.Dimensions {
@@ -256,25 +1006,81 @@ func (a *App) renderTable(gtx layout.Context) layout.Dimensions {
 							return layout.Dimensions{}
 						}),
 						layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-							return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-								// Display first prop found for demo
-								txt := "Node"
-								for _, f := range a.CurrentRes.Fields() {
-									if val, ok := node.Props[f.Attribute()]; ok {
-										txt = fmt.Sprintf("%v", val)
-										break
-									}
-								}
-								return material.Body1(a.Theme, txt).Layout(gtx)
+							return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20), Right: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle, Spacing: layout.SpaceBetween}.Layout(gtx,
+									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+										// Display first prop found for demo
+										txt := "Node"
+										for _, f := range a.CurrentRes.Fields() {
+											if val, ok := node.Props[f.Attribute()]; ok {
+												txt = formatProp(val)
+												break
+											}
+										}
+										return material.Body1(a.Theme, txt).Layout(gtx)
+									}),
+									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+										if a.DeleteBtns[i].Clicked(gtx) {
+											a.confirmDelete(a.CurrentRes.Label(), node.Id)
+										}
+										return material.Button(a.Theme, &a.DeleteBtns[i], "Delete").Layout(gtx)
+									}),
+								)
 							})
 						}),
 					)
 				})
+
+				// Infinite scroll: once the list is scrolled to its last
+				// rendered item and more records exist beyond CachedData,
+				// silently fetch and append the next page.
+				pos := a.TableList.Position
+				if !pos.BeforeEnd && pos.First+pos.Count >= len(a.CachedData) && int64(a.Page*a.PageSize+len(a.CachedData)) < a.Total {
+					a.loadMore()
+				}
+
+				return dims
 			})
 		}),
 	)
 }
 
+// pageSummary formats the "Showing X–Y of Z" pagination header for the
+// currently loaded page (or pages, once infinite scroll has appended more).
+func (a *App) pageSummary() string {
+	if len(a.CachedData) == 0 {
+		return fmt.Sprintf("Showing 0 of %d", a.Total)
+	}
+	start := a.Page*a.PageSize + 1
+	end := a.Page*a.PageSize + len(a.CachedData)
+	return fmt.Sprintf("Showing %d–%d of %d", start, end, a.Total)
+}
+
+// confirmDelete pops a ModalConfirm asking the user to confirm deleting
+// the node id (labeled label), and only issues the DETACH DELETE once
+// they accept.
+func (a *App) confirmDelete(label string, id int64) {
+	a.Modal.Show(
+		"Delete "+label,
+		fmt.Sprintf("This will permanently delete this %s. This cannot be undone.", label),
+		ModalConfirm,
+		func(_ string, ok bool) {
+			if !ok {
+				return
+			}
+			go func() {
+				if err := a.Repo.DeleteNode(context.Background(), label, id); err != nil {
+					a.Notify(LevelError, "Failed to delete %s: %v", label, err)
+					return
+				}
+				a.Notify(LevelSuccess, "%s deleted", label)
+				a.fetchData()
+				a.Window.Invalidate()
+			}()
+		},
+	)
+}
+
 func (a *App) renderForm(gtx layout.Context) layout.Dimensions {
 	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 		return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
@@ -299,9 +1105,13 @@ func (a *App) renderForm(gtx layout.Context) layout.Dimensions {
 						go func() {
 							data := make(map[string]interface{})
 							for _, f := range a.CurrentRes.Fields() {
-								data[f.Attribute()] = f.Value()
+								data[f.Attribute()] = f.TypedValue()
+							}
+							if err := a.Repo.Store(context.Background(), a.CurrentRes, data); err != nil {
+								a.Notify(LevelError, "Failed to save %s: %v", a.CurrentRes.Label(), err)
+								return
 							}
-							a.Repo.Store(context.Background(), a.CurrentRes, data)
+							a.Notify(LevelSuccess, "%s saved", a.CurrentRes.Label())
 							a.View = "index"
 							a.fetchData() // Refresh
 							a.Window.Invalidate()
@@ -318,23 +1128,446 @@ func (a *App) renderForm(gtx layout.Context) layout.Dimensions {
 	})
 }
 
+func (a *App) renderRelTable(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		// Header Area
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(material.H5(a.Theme, a.CurrentRel.Type()).Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if a.CreateRelBtn.Clicked(gtx) {
+						a.View = "relationship-create"
+						a.loadRelOptions()
+					}
+					btn := material.Button(a.Theme, &a.CreateRelBtn, "Create New")
+					btn.Background = NovaBlue
+					return btn.Layout(gtx)
+				}),
+			)
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+		// Table Card
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
+				return material.List(a.Theme, &a.RelTableList).Layout(gtx, len(a.CachedRelData), func(gtx layout.Context, i int) layout.Dimensions {
+					rec := a.CachedRelData[i]
+					from := rec.Values[0].(neo4j.Node)
+					to := rec.Values[2].(neo4j.Node)
+
+					return layout.Stack{}.Layout(gtx,
+						layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+							rect := clip.Rect{Min: image.Point{0, gtx.Constraints.Min.Y - 1}, Max: gtx.Constraints.Min}
+							paint.FillShape(gtx.Ops, BorderCol, rect.Op())
+							return layout.Dimensions{}
+						}),
+						layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+							return layout.Inset{Top: unit.Dp(15), Bottom: unit.Dp(15), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+									layout.Rigid(material.Body1(a.Theme, nodeLabel(a.CurrentRel.From(), from)).Layout),
+									layout.Rigid(layout.Spacer{Width: unit.Dp(10)}.Layout),
+									layout.Rigid(material.Body1(a.Theme, "→").Layout),
+									layout.Rigid(layout.Spacer{Width: unit.Dp(10)}.Layout),
+									layout.Rigid(material.Body1(a.Theme, nodeLabel(a.CurrentRel.To(), to)).Layout),
+								)
+							})
+						}),
+					)
+				})
+			})
+		}),
+	)
+}
+
+func (a *App) renderRelForm(gtx layout.Context) layout.Dimensions {
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Dp(400) // Fixed Width Form
+			return layout.Inset{Top: unit.Dp(30), Bottom: unit.Dp(30), Left: unit.Dp(30), Right: unit.Dp(30)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+
+				var kids []layout.FlexChild
+				kids = append(kids, layout.Rigid(material.H6(a.Theme, "Create "+a.CurrentRel.Type()).Layout))
+				kids = append(kids, layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout))
+
+				kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return a.RelForm.From.Layout(gtx, a.Theme)
+				}))
+				kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return a.RelForm.To.Layout(gtx, a.Theme)
+				}))
+
+				// Render relationship property fields
+				for _, f := range a.CurrentRel.Fields() {
+					fieldWidget := f // Capture closure
+					kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return fieldWidget.Layout(gtx, a.Theme)
+					}))
+				}
+
+				// Save Button
+				kids = append(kids, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if a.SaveRelBtn.Clicked(gtx) {
+						go func() {
+							fromIdx, toIdx := a.RelForm.From.SelectedIndex(), a.RelForm.To.SelectedIndex()
+							if fromIdx < 0 || toIdx < 0 {
+								a.Notify(LevelError, "Select both endpoints")
+								return
+							}
+							props := make(map[string]interface{})
+							for _, f := range a.CurrentRel.Fields() {
+								props[f.Attribute()] = f.TypedValue()
+							}
+							err := a.Repo.StoreRelationship(context.Background(), a.CurrentRel, a.RelForm.fromIDs[fromIdx], a.RelForm.toIDs[toIdx], props)
+							if err != nil {
+								a.Notify(LevelError, "Failed to save %s: %v", a.CurrentRel.Type(), err)
+								return
+							}
+							a.Notify(LevelSuccess, "%s saved", a.CurrentRel.Type())
+							a.View = "relationships"
+							a.fetchRelData()
+							a.Window.Invalidate()
+						}()
+					}
+					btn := material.Button(a.Theme, &a.SaveRelBtn, "Save Relationship")
+					btn.Background = NovaBlue
+					return btn.Layout(gtx)
+				}))
+
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, kids...)
+			})
+		})
+	})
+}
+
+// loadRelOptions fetches the node lists for the current relationship's two
+// endpoints so the create form's SelectFields can be populated.
+func (a *App) loadRelOptions() {
+	rr := a.CurrentRel
+	go func() {
+		fromRecs, _, err := a.Repo.Index(context.Background(), rr.From(), 0, relOptionLimit)
+		if err != nil {
+			a.Notify(LevelError, "Failed to load %s options: %v", rr.From().Label(), err)
+			return
+		}
+		toRecs, _, err := a.Repo.Index(context.Background(), rr.To(), 0, relOptionLimit)
+		if err != nil {
+			a.Notify(LevelError, "Failed to load %s options: %v", rr.To().Label(), err)
+			return
+		}
+		a.RelForm.setOptions(rr, fromRecs, toRecs)
+		a.Window.Invalidate()
+	}()
+}
+
+// Background Relationship Data Fetcher
+func (a *App) fetchRelData() {
+	go func() {
+		data, err := a.Repo.IndexRelationships(context.Background(), a.CurrentRel)
+		if err != nil {
+			a.Notify(LevelError, "Failed to load %s: %v", a.CurrentRel.Type(), err)
+			return
+		}
+		a.CachedRelData = data
+		a.Window.Invalidate()
+	}()
+}
+
 // Background Data Fetcher
 func (a *App) fetchData() {
+	if a.PageSize == 0 {
+		a.PageSize = defaultPageSize
+	}
+	a.Page = 0
+	a.loadPage(0)
+}
+
+// loadPage cancels any in-flight fetch for the current resource and loads
+// page (0-indexed) fresh, replacing CachedData.
+func (a *App) loadPage(page int) {
+	if a.cancelFetch != nil {
+		a.cancelFetch()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelFetch = cancel
+	res := a.CurrentRes
+	a.Page = page
+
 	go func() {
-		data, _ := a.Repo.Index(context.Background(), a.CurrentRes)
+		data, total, err := a.Repo.Index(ctx, res, page*a.PageSize, a.PageSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return // superseded by a later fetch
+			}
+			a.Notify(LevelError, "Failed to load %s: %v", res.Label(), err)
+			return
+		}
 		a.CachedData = data
+		a.Total = total
 		a.Window.Invalidate()
 	}()
 }
 
+// loadMore appends the next page of the current resource onto CachedData,
+// for infinite scroll. Page stays put, so pageSummary's range still starts
+// at the page the user explicitly navigated to and grows with each append.
+func (a *App) loadMore() {
+	if a.loadingMore {
+		return
+	}
+	a.loadingMore = true
+	res := a.CurrentRes
+	// CachedData starts at Page's first row, not at row 0.
+	offset := a.Page*a.PageSize + len(a.CachedData)
+
+	// Share the fetch-cancellation slot with loadPage so switching
+	// resources mid-scroll cancels this request too, instead of just
+	// discarding its result once it completes.
+	if a.cancelFetch != nil {
+		a.cancelFetch()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelFetch = cancel
+
+	go func() {
+		defer func() { a.loadingMore = false }()
+		data, total, err := a.Repo.Index(ctx, res, offset, a.PageSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return // superseded by a later fetch
+			}
+			a.Notify(LevelError, "Failed to load more %s: %v", res.Label(), err)
+			return
+		}
+		a.CachedData = append(a.CachedData, data...)
+		a.Total = total
+		a.Window.Invalidate()
+	}()
+}
+
+// renderCypherConsole draws the Query Console: a monospace editor for
+// arbitrary Cypher, a Run button, a history-recall button, and a scrollable
+// results area that renders []*neo4j.Record generically via CypherKeys.
+func (a *App) renderCypherConsole(gtx layout.Context) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(material.H5(a.Theme, "Query Console").Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if a.HistoryUpBtn.Clicked(gtx) {
+								a.recallHistory()
+							}
+							btn := material.Button(a.Theme, &a.HistoryUpBtn, "↑ History")
+							btn.Background = BgSidebar
+							return btn.Layout(gtx)
+						}),
+						layout.Rigid(layout.Spacer{Width: unit.Dp(10)}.Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if a.RunBtn.Clicked(gtx) {
+								a.runCypher()
+							}
+							btn := material.Button(a.Theme, &a.RunBtn, "Run")
+							btn.Background = NovaBlue
+							return btn.Layout(gtx)
+						}),
+					)
+				}),
+			)
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.Y = gtx.Dp(140)
+				return layout.UniformInset(unit.Dp(10)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					ed := material.Editor(a.Theme, &a.CypherEditor, "MATCH (n) RETURN n LIMIT 25")
+					ed.Font.Typeface = "Go Mono"
+					return ed.Layout(gtx)
+				})
+			})
+		}),
+		layout.Rigid(layout.Spacer{Height: unit.Dp(20)}.Layout),
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return DrawCard(gtx, func(gtx layout.Context) layout.Dimensions {
+				return material.List(a.Theme, &a.ResultsList).Layout(gtx, len(a.CypherResults), func(gtx layout.Context, i int) layout.Dimensions {
+					rec := a.CypherResults[i]
+					return layout.Stack{}.Layout(gtx,
+						layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+							rect := clip.Rect{Min: image.Point{0, gtx.Constraints.Min.Y - 1}, Max: gtx.Constraints.Min}
+							paint.FillShape(gtx.Ops, BorderCol, rect.Op())
+							return layout.Dimensions{}
+						}),
+						layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+							return layout.Inset{Top: unit.Dp(12), Bottom: unit.Dp(12), Left: unit.Dp(20)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								cols := make([]string, len(a.CypherKeys))
+								for ci, key := range a.CypherKeys {
+									cols[ci] = fmt.Sprintf("%s=%s", key, formatRecordValue(rec.Values[ci]))
+								}
+								l := material.Body2(a.Theme, strings.Join(cols, "  "))
+								l.Font.Typeface = "Go Mono"
+								return l.Layout(gtx)
+							})
+						}),
+					)
+				})
+			})
+		}),
+	)
+}
+
+// runCypher sends the editor's contents to the database, records it in
+// History on success, and surfaces any driver error via the toast
+// subsystem rather than swallowing it.
+func (a *App) runCypher() {
+	cypher := strings.TrimSpace(a.CypherEditor.Text())
+	if cypher == "" {
+		return
+	}
+	go func() {
+		keys, records, err := a.Repo.RunRaw(context.Background(), cypher, nil)
+		if err != nil {
+			a.Notify(LevelError, "Query failed: %v", err)
+			return
+		}
+		a.CypherKeys = keys
+		a.CypherResults = records
+		a.addHistory(cypher)
+		a.HistoryIdx = -1
+		a.Notify(LevelSuccess, "%d row(s)", len(records))
+		a.Window.Invalidate()
+	}()
+}
+
+// addHistory appends cypher to History, trims it to historyLimit, and
+// persists it via the stateStore so it survives a restart.
+func (a *App) addHistory(cypher string) {
+	a.History = append(a.History, cypher)
+	if len(a.History) > historyLimit {
+		a.History = a.History[len(a.History)-historyLimit:]
+	}
+	if a.history == nil {
+		return
+	}
+	if err := a.history.Save(a.History); err != nil {
+		log.Printf("save query history: %v", err)
+	}
+}
+
+// recallHistory walks HistoryUpBtn clicks backward through History, from
+// most recent query to oldest, loading each into CypherEditor.
+func (a *App) recallHistory() {
+	if len(a.History) == 0 {
+		return
+	}
+	if a.HistoryIdx+1 < len(a.History) {
+		a.HistoryIdx++
+	}
+	a.CypherEditor.SetText(a.History[len(a.History)-1-a.HistoryIdx])
+}
+
+// bootstrapCredentials gates Repository construction behind the
+// credentials PIN. On first run it walks the user through a chain of
+// modals (ModalInput for the URI and username, ModalPin for the password
+// and PIN) collecting the URI/username/password and a new PIN, then encrypts
+// them to store. On later runs it asks for the PIN via ModalPin and
+// decrypts. Only on success does it call ready with a working Repository.
+func (a *App) bootstrapCredentials(store *credStore, ready func(repo *Repository)) {
+	if !store.Exists() {
+		a.promptURI(store, ready)
+		return
+	}
+
+	a.promptPin(store, ready)
+}
+
+// promptURI is the first step of the bootstrapCredentials wizard. Canceling
+// re-shows this same step rather than restarting the wizard, so fields
+// collected in later steps are never reached yet and there's nothing to
+// lose here.
+func (a *App) promptURI(store *credStore, ready func(repo *Repository)) {
+	a.Modal.Show("Neo4j URI", "Enter the connection URI for your Neo4j database.", ModalInput, func(uri string, ok bool) {
+		if !ok {
+			// Without credentials there's no usable Repository, so
+			// leaving the modal closed would hand back a fully
+			// interactive UI wired to a nil *Repository. Re-show the
+			// step the user backed out of instead.
+			a.promptURI(store, ready)
+			return
+		}
+		a.promptUsername(store, ready, uri)
+	})
+}
+
+// promptUsername re-shows itself on cancel, keeping the already-collected
+// uri instead of restarting the wizard from promptURI.
+func (a *App) promptUsername(store *credStore, ready func(repo *Repository), uri string) {
+	a.Modal.Show("Username", "Enter the Neo4j username.", ModalInput, func(username string, ok bool) {
+		if !ok {
+			a.promptUsername(store, ready, uri)
+			return
+		}
+		a.promptPassword(store, ready, uri, username)
+	})
+}
+
+// promptPassword re-shows itself on cancel, keeping uri/username instead of
+// restarting the wizard from promptURI.
+func (a *App) promptPassword(store *credStore, ready func(repo *Repository), uri, username string) {
+	a.Modal.Show("Password", "Enter the Neo4j password.", ModalPin, func(password string, ok bool) {
+		if !ok {
+			a.promptPassword(store, ready, uri, username)
+			return
+		}
+		a.promptSetPIN(store, ready, uri, username, password)
+	})
+}
+
+// promptSetPIN is the final step of the bootstrapCredentials wizard. It
+// re-shows itself both on cancel and on a store.Save failure (e.g. a
+// transient disk error), keeping uri/username/password instead of
+// restarting the wizard and losing them.
+func (a *App) promptSetPIN(store *credStore, ready func(repo *Repository), uri, username, password string) {
+	a.Modal.Show("Set a PIN", "Choose a PIN to protect these credentials on disk.", ModalPin, func(pin string, ok bool) {
+		if !ok {
+			a.promptSetPIN(store, ready, uri, username, password)
+			return
+		}
+		creds := credentials{URI: uri, Username: username, Password: password}
+		if err := store.Save(pin, creds); err != nil {
+			a.Notify(LevelError, "Failed to save credentials: %v", err)
+			a.promptSetPIN(store, ready, uri, username, password)
+			return
+		}
+		ready(NewRepository(creds.URI, creds.Username, creds.Password))
+	})
+}
+
+// promptPin shows a ModalPin dialog and decrypts the credentials store
+// with the entered PIN, re-prompting on a bad PIN (an AES-GCM auth
+// failure) instead of failing silently.
+func (a *App) promptPin(store *credStore, ready func(repo *Repository)) {
+	a.Modal.Show("Unlock Gova", "Enter your PIN to unlock the stored Neo4j credentials.", ModalPin, func(pin string, ok bool) {
+		if !ok {
+			// Same reasoning as promptURI: no Repository exists
+			// yet, so canceling must re-show the unlock prompt rather than
+			// leave a nil-Repo UI clickable.
+			a.promptPin(store, ready)
+			return
+		}
+		creds, err := store.Load(pin)
+		if err != nil {
+			a.Notify(LevelError, "Incorrect PIN")
+			a.promptPin(store, ready)
+			return
+		}
+		ready(NewRepository(creds.URI, creds.Username, creds.Password))
+	})
+}
+
 // ==========================================
 // 6. MAIN ENTRY
 // ==========================================
 
 func main() {
-	// Setup Database (Change credentials to match your local Neo4j)
-	repo := NewRepository("neo4j+s://c46cdfa4.databases.neo4j.io", "neo4j", "bs5GPhugcnWvMaD39WD29QSzSx9jnhZwcQRfthW75hg")
-
 	// Setup UI
 	w := app.NewWindow(app.Title("Gova Admin"), app.Size(unit.Dp(1024), unit.Dp(768)))
 	th := material.NewTheme()
@@ -342,21 +1575,48 @@ func main() {
 
 	// Init Resources
 	resources := []Resource{UserResource{}}
-	
-	// Init Application State
+	relationships := []RelationshipResource{FriendOfRelationship{}}
+
+	// Init query history store
+	history, err := newStateStore("history.json")
+	if err != nil {
+		log.Printf("init query history: %v", err)
+	}
+
+	// Init Application State. Repo is left nil until the credentials PIN
+	// modal below unlocks it.
 	application := &App{
-		Repo:       repo,
-		Theme:      th,
-		Resources:  resources,
-		CurrentRes: resources[0],
-		View:       "index",
-		Window:     w,
-		NavButtons: make([]*widget.Clickable, len(resources)),
+		Theme:         th,
+		Resources:     resources,
+		CurrentRes:    resources[0],
+		Relationships: relationships,
+		View:          "index",
+		Window:        w,
+		NavButtons:    make([]*widget.Clickable, len(resources)),
+		RelButtons:    make([]*widget.Clickable, len(relationships)),
+		history:       history,
+		HistoryIdx:    -1,
 	}
 	for i := range application.NavButtons { application.NavButtons[i] = &widget.Clickable{} }
+	for i := range application.RelButtons { application.RelButtons[i] = &widget.Clickable{} }
+	if history != nil {
+		if err := history.Load(&application.History); err != nil {
+			log.Printf("load query history: %v", err)
+		}
+	}
 
-	// Initial Data Load
-	application.fetchData()
+	// Gate the database connection behind the credentials PIN modal; only
+	// once it's unlocked (or freshly created) do we build a Repository and
+	// load the initial data.
+	creds, err := newCredStore()
+	if err != nil {
+		log.Fatal(err)
+	}
+	application.bootstrapCredentials(creds, func(repo *Repository) {
+		application.Repo = repo
+		application.fetchData()
+		w.Invalidate()
+	})
 
 	// Main Loop
 	go func() {
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestCredStore(t *testing.T) *credStore {
+	t.Helper()
+	return &credStore{path: filepath.Join(t.TempDir(), "creds.enc")}
+}
+
+func TestCredStoreSaveLoadRoundTrip(t *testing.T) {
+	store := newTestCredStore(t)
+	want := credentials{URI: "neo4j://localhost:7687", Username: "neo4j", Password: "s3cr3t"}
+
+	if err := store.Save("1234", want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if !store.Exists() {
+		t.Fatal("Exists() = false after Save")
+	}
+
+	got, err := store.Load("1234")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got != want {
+		t.Fatalf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCredStoreLoadWrongPIN(t *testing.T) {
+	store := newTestCredStore(t)
+	if err := store.Save("1234", credentials{URI: "neo4j://localhost:7687"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if _, err := store.Load("0000"); err == nil {
+		t.Fatal("Load() with wrong PIN succeeded, want error")
+	}
+}
+
+func TestCredStoreLoadTruncatedFile(t *testing.T) {
+	store := newTestCredStore(t)
+	if err := store.Save("1234", credentials{URI: "neo4j://localhost:7687"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	data, err := os.ReadFile(store.path)
+	if err != nil {
+		t.Fatalf("reading creds file: %v", err)
+	}
+	if err := os.WriteFile(store.path, data[:10], 0o600); err != nil {
+		t.Fatalf("truncating creds file: %v", err)
+	}
+
+	if _, err := store.Load("1234"); err == nil {
+		t.Fatal("Load() on truncated file succeeded, want error")
+	}
+}